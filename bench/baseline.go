@@ -0,0 +1,50 @@
+package bench
+
+import "fmt"
+
+// Regression describes a sample whose trimmed size grew beyond the
+// allowed threshold relative to a stored baseline run.
+type Regression struct {
+	Name           string
+	BaselineBytes  int
+	CurrentBytes   int
+	GrowthPercent  float64
+	AllowedPercent float64
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s: trimmed WAT grew %.1f%% (%d -> %d bytes), allowed %.1f%%",
+		r.Name, r.GrowthPercent, r.BaselineBytes, r.CurrentBytes, r.AllowedPercent)
+}
+
+// CompareToBaseline reports every sample in current whose trimmed WAT
+// size grew by more than maxGrowthPercent relative to the matching
+// baseline entry. Samples present in current but missing from baseline
+// are ignored, since they have no prior size to compare against.
+func CompareToBaseline(baseline, current Report, maxGrowthPercent float64) []Regression {
+	baseByName := make(map[string]Result, len(baseline.Results))
+	for _, r := range baseline.Results {
+		baseByName[r.Name] = r
+	}
+
+	var regressions []Regression
+	for _, cur := range current.Results {
+		base, ok := baseByName[cur.Name]
+		if !ok || base.TrimmedWATBytes == 0 {
+			continue
+		}
+
+		growth := (float64(cur.TrimmedWATBytes) - float64(base.TrimmedWATBytes)) / float64(base.TrimmedWATBytes) * 100
+		if growth > maxGrowthPercent {
+			regressions = append(regressions, Regression{
+				Name:           cur.Name,
+				BaselineBytes:  base.TrimmedWATBytes,
+				CurrentBytes:   cur.TrimmedWATBytes,
+				GrowthPercent:  growth,
+				AllowedPercent: maxGrowthPercent,
+			})
+		}
+	}
+
+	return regressions
+}
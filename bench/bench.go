@@ -0,0 +1,95 @@
+// Package bench measures how effectively the trimmer shrinks each sample
+// in the corpus, so a regression in trimming ratio on any one program
+// shape is visible per-commit rather than hidden in an aggregate number.
+package bench
+
+import (
+	"context"
+	"time"
+
+	"github.com/tamadalab/wasm-wat-trimming/verify"
+)
+
+// Sample is one corpus entry to benchmark, identified by its WAT path.
+type Sample struct {
+	Name    string
+	WATPath string
+}
+
+// Result holds the measurements for a single Sample.
+type Result struct {
+	Name             string  `json:"name"`
+	OriginalWATBytes int     `json:"original_wat_bytes"`
+	TrimmedWATBytes  int     `json:"trimmed_wat_bytes"`
+	TrimmedWASMBytes int     `json:"trimmed_wasm_bytes"`
+	TrimDuration     float64 `json:"trim_duration_seconds"`
+	TrimRatio        float64 `json:"trim_ratio"`
+	// Executed reports whether Measure was given a Runtime and so
+	// actually ran the module, rather than being inferred from
+	// ExecBefore/ExecAfter, which can be legitimately zero for a module
+	// that runs in under a microsecond.
+	Executed   bool    `json:"executed,omitempty"`
+	ExecBefore float64 `json:"exec_before_seconds,omitempty"`
+	ExecAfter  float64 `json:"exec_after_seconds,omitempty"`
+}
+
+// Measure runs tr and asm over sample and records byte counts and timing.
+// If rt is non-nil, it also runs the module under rt before and after
+// trimming, with args as its argv, and records wall-clock execution time
+// for each in Result.ExecBefore/ExecAfter.
+func Measure(sample Sample, wat []byte, tr verify.Trimmer, asm verify.Assembler, rt verify.Runtime, args []string) (Result, error) {
+	start := time.Now()
+	trimmed, err := tr.Trim(wat)
+	duration := time.Since(start)
+	if err != nil {
+		return Result{}, err
+	}
+
+	wasm, err := asm.Assemble(trimmed)
+	if err != nil {
+		return Result{}, err
+	}
+
+	original := len(wat)
+	after := len(trimmed)
+
+	ratio := 0.0
+	if original > 0 {
+		ratio = 1 - float64(after)/float64(original)
+	}
+
+	result := Result{
+		Name:             sample.Name,
+		OriginalWATBytes: original,
+		TrimmedWATBytes:  after,
+		TrimmedWASMBytes: len(wasm),
+		TrimDuration:     duration.Seconds(),
+		TrimRatio:        ratio,
+	}
+
+	if rt == nil {
+		return result, nil
+	}
+
+	originalWASM, err := asm.Assemble(wat)
+	if err != nil {
+		return Result{}, err
+	}
+
+	ctx := context.Background()
+	result.Executed = true
+
+	start = time.Now()
+	if _, _, err := rt.Run(ctx, originalWASM, args); err != nil {
+		return Result{}, err
+	}
+	result.ExecBefore = time.Since(start).Seconds()
+
+	start = time.Now()
+	if _, _, err := rt.Run(ctx, wasm, args); err != nil {
+		return Result{}, err
+	}
+	result.ExecAfter = time.Since(start).Seconds()
+
+	return result, nil
+}
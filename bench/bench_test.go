@@ -0,0 +1,46 @@
+package bench
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tamadalab/wasm-wat-trimming/trimmer"
+	"github.com/tamadalab/wasm-wat-trimming/verify"
+)
+
+// trimmerFunc adapts trimmer.Trim to the verify.Trimmer interface.
+type trimmerFunc func([]byte) ([]byte, error)
+
+func (f trimmerFunc) Trim(wat []byte) ([]byte, error) { return f(wat) }
+
+func BenchmarkTrim(b *testing.B) {
+	tr := trimmerFunc(trimmer.Trim)
+
+	for _, c := range verify.Corpus {
+		c := c
+		b.Run(c.Name, func(b *testing.B) {
+			// Corpus's WATPath is relative to the repo root (how the CLI
+			// uses it); go test runs with this package's directory as
+			// cwd, one level down.
+			watPath := filepath.Join("..", c.WATPath)
+			wat, err := os.ReadFile(watPath)
+			if os.IsNotExist(err) {
+				b.Fatalf("%s not built yet, run `make fixtures` to generate it", watPath)
+			}
+			if err != nil {
+				b.Fatalf("read %s: %v", watPath, err)
+			}
+
+			sample := Sample{Name: c.Name, WATPath: c.WATPath}
+			asm := verify.WAT2WASMAssembler{}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := Measure(sample, wat, tr, asm, nil, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Report is the full set of Results from one bench run.
+type Report struct {
+	Results []Result `json:"results"`
+}
+
+// JSON renders the report as indented, machine-readable JSON.
+func (r Report) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Markdown renders the report as a table, one row per sample. When any
+// result carries execution timing (see Measure's rt parameter), the
+// table grows an Exec Before/Exec After column pair.
+func (r Report) Markdown() string {
+	var withExec bool
+	for _, res := range r.Results {
+		if res.Executed {
+			withExec = true
+			break
+		}
+	}
+
+	var b strings.Builder
+
+	if withExec {
+		b.WriteString("| Sample | Original WAT | Trimmed WAT | Trimmed WASM | Trim Time | Ratio | Exec Before | Exec After |\n")
+		b.WriteString("|---|---:|---:|---:|---:|---:|---:|---:|\n")
+	} else {
+		b.WriteString("| Sample | Original WAT | Trimmed WAT | Trimmed WASM | Trim Time | Ratio |\n")
+		b.WriteString("|---|---:|---:|---:|---:|---:|\n")
+	}
+
+	for _, res := range r.Results {
+		if withExec {
+			fmt.Fprintf(&b, "| %s | %d | %d | %d | %.3fs | %.1f%% | %.3fs | %.3fs |\n",
+				res.Name, res.OriginalWATBytes, res.TrimmedWATBytes, res.TrimmedWASMBytes,
+				res.TrimDuration, res.TrimRatio*100, res.ExecBefore, res.ExecAfter)
+			continue
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %.3fs | %.1f%% |\n",
+			res.Name, res.OriginalWATBytes, res.TrimmedWATBytes, res.TrimmedWASMBytes,
+			res.TrimDuration, res.TrimRatio*100)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tamadalab/wasm-wat-trimming/bench"
+	"github.com/tamadalab/wasm-wat-trimming/verify"
+)
+
+// runBench implements the `wat-trim bench` subcommand: it measures
+// trimming effectiveness for every sample in verify.Corpus and prints a
+// Markdown table. With -baseline, it also fails if any sample's trimmed
+// size grew by more than -max-growth percent relative to the stored run.
+func runBench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	baselinePath := fs.String("baseline", "", "path to a JSON report from a previous run to diff against")
+	maxGrowth := fs.Float64("max-growth", 5.0, "fail if any sample's trimmed size grows by more than this percent")
+	jsonOut := fs.String("json", "", "path to write the machine-readable JSON summary to")
+	exec := fs.Bool("exec", false, "also time executing each sample under wazero before and after trimming")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	tr := trimmerAdapter{}
+	asm := verify.WAT2WASMAssembler{}
+
+	var rt verify.Runtime
+	if *exec {
+		rt = verify.NewWazeroRuntime()
+	}
+
+	var results []bench.Result
+	for _, c := range verify.Corpus {
+		wat, err := os.ReadFile(c.WATPath)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", c.WATPath, err)
+		}
+
+		res, err := bench.Measure(bench.Sample{Name: c.Name, WATPath: c.WATPath}, wat, tr, asm, rt, c.Args)
+		if err != nil {
+			return fmt.Errorf("measure %s: %w", c.Name, err)
+		}
+
+		results = append(results, res)
+	}
+
+	report := bench.Report{Results: results}
+	fmt.Print(report.Markdown())
+
+	if *jsonOut != "" {
+		data, err := report.JSON()
+		if err != nil {
+			return fmt.Errorf("marshal report: %w", err)
+		}
+		if err := os.WriteFile(*jsonOut, data, 0o644); err != nil {
+			return fmt.Errorf("write %s: %w", *jsonOut, err)
+		}
+	}
+
+	if *baselinePath == "" {
+		return nil
+	}
+
+	baselineData, err := os.ReadFile(*baselinePath)
+	if err != nil {
+		return fmt.Errorf("read baseline %s: %w", *baselinePath, err)
+	}
+
+	var baseline bench.Report
+	if err := json.Unmarshal(baselineData, &baseline); err != nil {
+		return fmt.Errorf("parse baseline %s: %w", *baselinePath, err)
+	}
+
+	regressions := bench.CompareToBaseline(baseline, report, *maxGrowth)
+	if len(regressions) == 0 {
+		return nil
+	}
+
+	for _, r := range regressions {
+		fmt.Fprintln(os.Stderr, r.String())
+	}
+
+	return fmt.Errorf("bench: %d sample(s) regressed past the baseline", len(regressions))
+}
@@ -0,0 +1,31 @@
+// Command wat-trim is the CLI entry point for the WAT trimmer.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: wat-trim <trim|verify|bench> [flags]")
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "trim":
+		err = runTrim(os.Args[2:])
+	case "verify":
+		err = runVerify(os.Args[2:])
+	case "bench":
+		err = runBench(os.Args[2:])
+	default:
+		err = fmt.Errorf("unknown subcommand %q", os.Args[1])
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tamadalab/wasm-wat-trimming/policy"
+)
+
+// runTrim implements the `wat-trim trim` subcommand: it trims a WAT file,
+// retaining every function a policy protects.
+func runTrim(args []string) error {
+	fs := flag.NewFlagSet("trim", flag.ExitOnError)
+	policyPath := fs.String("policy", "", "path to a JSON or YAML retention policy file")
+	preserveExports := fs.Bool("preserve-exports", true, "retain main, _start, _initialize and the policy's functions and start_functions lists; (export ...) functions are retained either way")
+	out := fs.String("o", "", "output path for the trimmed WAT (default: stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: wat-trim trim [flags] <input.wat>")
+	}
+
+	pol := policy.Default()
+	if *policyPath != "" {
+		loaded, err := policy.Load(*policyPath)
+		if err != nil {
+			return err
+		}
+		pol = loaded
+	}
+	if !*preserveExports {
+		pol.Functions = nil
+		pol.StartFunctions = nil
+	}
+
+	wat, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("read %s: %w", fs.Arg(0), err)
+	}
+
+	trimmed, err := trimmerAdapter{}.TrimWithPolicy(wat, pol)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(trimmed)
+		return err
+	}
+	return os.WriteFile(*out, trimmed, 0o644)
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/tamadalab/wasm-wat-trimming/policy"
+	"github.com/tamadalab/wasm-wat-trimming/trimmer"
+)
+
+// trimmerAdapter adapts the trimmer package's entry point to the
+// verify.Trimmer interface.
+type trimmerAdapter struct{}
+
+func (trimmerAdapter) Trim(wat []byte) ([]byte, error) {
+	return trimmer.Trim(wat)
+}
+
+// TrimWithPolicy trims wat while retaining every name pol protects.
+func (trimmerAdapter) TrimWithPolicy(wat []byte, pol policy.Policy) ([]byte, error) {
+	return trimmer.TrimWithRetain(wat, pol)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tamadalab/wasm-wat-trimming/verify"
+)
+
+// runVerify implements the `wat-trim verify` subcommand: it assembles,
+// runs, trims, reassembles, and re-runs every case in verify.Corpus, and
+// reports the first behavioral divergence it finds.
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	runtimeName := fs.String("runtime", "wazero", "WASM runtime to verify under: wazero or wasmtime")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var rt verify.Runtime
+	switch *runtimeName {
+	case "wazero":
+		rt = verify.NewWazeroRuntime()
+	case "wasmtime":
+		rt = verify.NewWasmtimeRuntime()
+	default:
+		return fmt.Errorf("unknown runtime %q (want wazero or wasmtime)", *runtimeName)
+	}
+
+	asm := verify.WAT2WASMAssembler{}
+	tr := trimmerAdapter{}
+
+	failed := false
+	for _, c := range verify.Corpus {
+		wat, err := os.ReadFile(c.WATPath)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", c.WATPath, err)
+		}
+
+		if _, err := verify.Run(context.Background(), rt, asm, tr, c, wat); err != nil {
+			fmt.Fprintf(os.Stderr, "FAIL %s: %v\n", c.Name, err)
+			failed = true
+			continue
+		}
+
+		fmt.Printf("ok   %s\n", c.Name)
+	}
+
+	if failed {
+		return fmt.Errorf("verify: one or more samples diverged after trimming")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Package policy describes which functions a trim run must retain
+// verbatim, regardless of what static reachability analysis concludes.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Policy lists the functions, exports, and start-function identifiers
+// that must survive trimming.
+type Policy struct {
+	Functions      []string `json:"functions,omitempty" yaml:"functions,omitempty"`
+	Exports        []string `json:"exports,omitempty" yaml:"exports,omitempty"`
+	StartFunctions []string `json:"start_functions,omitempty" yaml:"start_functions,omitempty"`
+}
+
+// Default is always merged into a loaded Policy: main, _start, and
+// _initialize are the entry points Go/WASM toolchains rely on, and any
+// name appearing in a WAT (export ...) clause is retained automatically
+// by ShouldRetain without needing to be listed explicitly.
+func Default() Policy {
+	return Policy{
+		Functions:      []string{"main", "_start", "_initialize"},
+		StartFunctions: []string{"_start", "_initialize"},
+	}
+}
+
+// Load reads a policy file in JSON or YAML, picked by file extension,
+// and merges it with Default.
+func Load(path string) (Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, fmt.Errorf("read policy %s: %w", path, err)
+	}
+
+	var p Policy
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &p); err != nil {
+			return Policy{}, fmt.Errorf("parse policy %s: %w", path, err)
+		}
+	case ".json":
+		if err := json.Unmarshal(data, &p); err != nil {
+			return Policy{}, fmt.Errorf("parse policy %s: %w", path, err)
+		}
+	default:
+		return Policy{}, fmt.Errorf("policy %s: unsupported extension %q (want .json, .yaml, or .yml)", path, ext)
+	}
+
+	return Merge(Default(), p), nil
+}
+
+// Merge combines two policies, keeping the union of each list.
+func Merge(a, b Policy) Policy {
+	return Policy{
+		Functions:      union(a.Functions, b.Functions),
+		Exports:        union(a.Exports, b.Exports),
+		StartFunctions: union(a.StartFunctions, b.StartFunctions),
+	}
+}
+
+// RetainNames returns every name this policy protects from trimming,
+// deduplicated.
+func (p Policy) RetainNames() []string {
+	return union(union(p.Functions, p.Exports), p.StartFunctions)
+}
+
+func union(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var out []string
+	for _, names := range [][]string{a, b} {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				out = append(out, name)
+			}
+		}
+	}
+	return out
+}
+
+var exportRe = regexp.MustCompile(`\(export\s+"[^"]+"\s*\(func\s+\$([A-Za-z0-9_.\-]+)\)\)`)
+
+// ExportsOf returns the internal (func $name) identifier targeted by
+// every WAT (export ...) clause, without the leading "$" - not the
+// host-visible export string, which is a different namespace: wasm2wat
+// debug names are package-qualified (e.g. "main.AddInts"), so an export
+// string like "AddInts" commonly has no function of that name at all.
+func ExportsOf(wat []byte) []string {
+	var names []string
+	for _, m := range exportRe.FindAllSubmatch(wat, -1) {
+		names = append(names, string(m[1]))
+	}
+	return names
+}
+
+// ShouldRetain reports whether name must survive trimming under p, either
+// because it was listed explicitly or because wat exports it.
+func (p Policy) ShouldRetain(name string, wat []byte) bool {
+	for _, n := range p.RetainNames() {
+		if n == name {
+			return true
+		}
+	}
+	for _, exported := range ExportsOf(wat) {
+		if exported == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package policy
+
+import (
+	"os"
+	"testing"
+)
+
+// TestShouldRetainExports checks that the default policy retains every
+// host-callable export defined in samples/exports, so trimming a
+// library-style module can't silently delete its entry points.
+//
+// It calls ShouldRetain with the (func $name) identifier a real trim run
+// passes (see trimmer.go), not the host-visible export string - wasm2wat's
+// debug names are package-qualified, so the two diverge.
+func TestShouldRetainExports(t *testing.T) {
+	const watPath = "../samples/exports/exports.wat"
+
+	wat, err := os.ReadFile(watPath)
+	if os.IsNotExist(err) {
+		t.Fatalf("%s not built yet, run `make fixtures` to generate it", watPath)
+	}
+	if err != nil {
+		t.Fatalf("read %s: %v", watPath, err)
+	}
+
+	p := Default()
+	for _, name := range []string{"main.AddInts", "main.ReverseBits"} {
+		if !p.ShouldRetain(name, wat) {
+			t.Errorf("ShouldRetain(%q) = false, want true", name)
+		}
+	}
+}
+
+// TestShouldRetainExportsUsesInternalName proves ExportsOf resolves an
+// (export ...) clause to the func identifier it targets rather than the
+// export string itself. The two are different namespaces that commonly
+// diverge - calling ShouldRetain with the export string the way this test
+// used to would be tautological and couldn't catch that.
+func TestShouldRetainExportsUsesInternalName(t *testing.T) {
+	const wat = `(module
+  (func $main.AddInts (param i32 i32) (result i32)
+    local.get 0)
+  (export "AddInts" (func $main.AddInts)))
+`
+	p := Default()
+
+	if !p.ShouldRetain("main.AddInts", []byte(wat)) {
+		t.Error(`ShouldRetain("main.AddInts") = false, want true: that's the (func ...) identifier the export targets`)
+	}
+	if p.ShouldRetain("AddInts", []byte(wat)) {
+		t.Error(`ShouldRetain("AddInts") = true, want false: that's the export string, not a function identifier`)
+	}
+}
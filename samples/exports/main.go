@@ -0,0 +1,24 @@
+package main
+
+// AddInts is called only from the host; it has no caller inside this
+// module, so a naive reachability pass would otherwise trim it away.
+//
+//go:wasmexport AddInts
+func AddInts(a, b int32) int32 {
+	return a + b
+}
+
+// ReverseBits is called only from the host. go:wasmexport only supports
+// scalar/pointer results (no string), so this stands in for a
+// string-shaped export while staying within that ABI restriction.
+//
+//go:wasmexport ReverseBits
+func ReverseBits(x uint32) uint32 {
+	var out uint32
+	for i := 0; i < 32; i++ {
+		out = out<<1 | (x>>i)&1
+	}
+	return out
+}
+
+func main() {}
@@ -0,0 +1,25 @@
+package main
+
+import "fmt"
+
+// []*intでメモ化したフィボナッチ数列
+func fibMemo(n int, memo []*int) int {
+	if n <= 1 {
+		return n
+	}
+	if memo[n] != nil {
+		return *memo[n]
+	}
+
+	result := fibMemo(n-1, memo) + fibMemo(n-2, memo)
+	memo[n] = &result
+
+	return result
+}
+
+func main() {
+	n := 20
+	memo := make([]*int, n+1)
+
+	fmt.Printf("Fibonacci(%d) = %d\n", n, fibMemo(n, memo))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Product is sorted by a caller-supplied key via a generic comparator.
+type Product struct {
+	Name  string
+	Price float64
+}
+
+// sortByKey sorts items in place using slices.SortFunc, comparing the
+// value each item maps to through key.
+func sortByKey[T any, K cmp.Ordered](items []T, key func(T) K) {
+	slices.SortFunc(items, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+func main() {
+	products := []Product{
+		{"Widget", 9.99},
+		{"Gadget", 4.5},
+		{"Gizmo", 14.0},
+	}
+
+	fmt.Println("Before:", products)
+
+	sortByKey(products, func(p Product) float64 { return p.Price })
+
+	fmt.Println("After:", products)
+}
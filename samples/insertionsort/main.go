@@ -0,0 +1,26 @@
+package main
+
+import "fmt"
+
+// 挿入ソート関数
+func insertionSort(arr []int) {
+	for i := 1; i < len(arr); i++ {
+		key := arr[i]
+		j := i - 1
+		for j >= 0 && arr[j] > key {
+			arr[j+1] = arr[j]
+			j--
+		}
+		arr[j+1] = key
+	}
+}
+
+func main() {
+	data := []int{64, 25, 12, 22, 11, 90, 88, 15, 76, 34}
+
+	fmt.Println("Original:", data)
+
+	insertionSort(data)
+
+	fmt.Println("Sorted:", data)
+}
@@ -0,0 +1,38 @@
+package main
+
+import "fmt"
+
+// 部分和問題を[][]boolのDPテーブルで解く
+func subsetSum(nums []int, target int) bool {
+	n := len(nums)
+	dp := make([][]bool, n+1)
+	for i := range dp {
+		dp[i] = make([]bool, target+1)
+		dp[i][0] = true
+	}
+
+	for i := 1; i <= n; i++ {
+		for t := 1; t <= target; t++ {
+			dp[i][t] = dp[i-1][t]
+			if nums[i-1] <= t && dp[i-1][t-nums[i-1]] {
+				dp[i][t] = true
+			}
+		}
+	}
+
+	return dp[n][target]
+}
+
+func main() {
+	nums := []int{3, 34, 4, 12, 5, 2}
+	target := 9
+
+	fmt.Println("Numbers:", nums)
+	fmt.Println("Target:", target)
+
+	if subsetSum(nums, target) {
+		fmt.Println("A subset with the given sum exists")
+	} else {
+		fmt.Println("No subset with the given sum exists")
+	}
+}
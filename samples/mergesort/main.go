@@ -0,0 +1,47 @@
+package main
+
+import "fmt"
+
+// マージソート関数（中間スライスを再帰的に確保する）
+func mergeSort(arr []int) []int {
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	mid := len(arr) / 2
+	left := mergeSort(arr[:mid])
+	right := mergeSort(arr[mid:])
+
+	return merge(left, right)
+}
+
+// 2つのソート済みスライスをマージする
+func merge(left, right []int) []int {
+	result := make([]int, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		if left[i] <= right[j] {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+
+	result = append(result, left[i:]...)
+	result = append(result, right[j:]...)
+
+	return result
+}
+
+func main() {
+	data := []int{64, 25, 12, 22, 11, 90, 88, 15, 76, 34}
+
+	fmt.Println("Original:", data)
+
+	sorted := mergeSort(data)
+
+	fmt.Println("Sorted:", sorted)
+}
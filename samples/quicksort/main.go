@@ -0,0 +1,50 @@
+package main
+
+import "fmt"
+
+// 明示的なスタックを使った非再帰のクイックソート
+func quickSortIterative(arr []int) {
+	if len(arr) < 2 {
+		return
+	}
+
+	type bounds struct{ lo, hi int }
+	stack := []bounds{{0, len(arr) - 1}}
+
+	for len(stack) > 0 {
+		b := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if b.lo >= b.hi {
+			continue
+		}
+
+		p := partition(arr, b.lo, b.hi)
+		stack = append(stack, bounds{b.lo, p - 1})
+		stack = append(stack, bounds{p + 1, b.hi})
+	}
+}
+
+// Lomuto分割方式でピボットの位置を確定する
+func partition(arr []int, lo, hi int) int {
+	pivot := arr[hi]
+	i := lo
+	for j := lo; j < hi; j++ {
+		if arr[j] < pivot {
+			arr[i], arr[j] = arr[j], arr[i]
+			i++
+		}
+	}
+	arr[i], arr[hi] = arr[hi], arr[i]
+	return i
+}
+
+func main() {
+	data := []int{64, 25, 12, 22, 11, 90, 88, 15, 76, 34}
+
+	fmt.Println("Original:", data)
+
+	quickSortIterative(data)
+
+	fmt.Println("Sorted:", data)
+}
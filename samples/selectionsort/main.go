@@ -0,0 +1,27 @@
+package main
+
+import "fmt"
+
+// 選択ソート関数
+func selectionSort(arr []int) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		minIdx := i
+		for j := i + 1; j < n; j++ {
+			if arr[j] < arr[minIdx] {
+				minIdx = j
+			}
+		}
+		arr[i], arr[minIdx] = arr[minIdx], arr[i]
+	}
+}
+
+func main() {
+	data := []int{64, 25, 12, 22, 11, 90, 88, 15, 76, 34}
+
+	fmt.Println("Original:", data)
+
+	selectionSort(data)
+
+	fmt.Println("Sorted:", data)
+}
@@ -0,0 +1,29 @@
+package main
+
+import "fmt"
+
+// 可変ギャップを使ったシェルソート関数
+func shellSort(arr []int) {
+	n := len(arr)
+	for gap := n / 2; gap > 0; gap /= 2 {
+		for i := gap; i < n; i++ {
+			temp := arr[i]
+			j := i
+			for j >= gap && arr[j-gap] > temp {
+				arr[j] = arr[j-gap]
+				j -= gap
+			}
+			arr[j] = temp
+		}
+	}
+}
+
+func main() {
+	data := []int{64, 25, 12, 22, 11, 90, 88, 15, 76, 34}
+
+	fmt.Println("Original:", data)
+
+	shellSort(data)
+
+	fmt.Println("Sorted:", data)
+}
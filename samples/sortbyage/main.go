@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Person represents someone with a name and an age.
+type Person struct {
+	Name string
+	Age  int
+}
+
+// ByAge implements sort.Interface so sort.Sort dispatches through
+// Len/Less/Swap via call_indirect in the compiled WASM.
+type ByAge []Person
+
+func (a ByAge) Len() int           { return len(a) }
+func (a ByAge) Less(i, j int) bool { return a[i].Age < a[j].Age }
+func (a ByAge) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+func main() {
+	people := []Person{
+		{"Alice", 30},
+		{"Bob", 25},
+		{"Carol", 35},
+		{"Dave", 20},
+	}
+
+	fmt.Println("Before:", people)
+
+	sort.Sort(ByAge(people))
+
+	fmt.Println("After:", people)
+}
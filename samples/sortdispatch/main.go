@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// byLength sorts strings by how long they are.
+type byLength []string
+
+func (s byLength) Len() int           { return len(s) }
+func (s byLength) Less(i, j int) bool { return len(s[i]) < len(s[j]) }
+func (s byLength) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// byValue sorts ints in ascending order.
+type byValue []int
+
+func (s byValue) Len() int           { return len(s) }
+func (s byValue) Less(i, j int) bool { return s[i] < s[j] }
+func (s byValue) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func main() {
+	// Each element holds a distinct sort.Interface implementation, so the
+	// loop below dispatches to a different Less/Swap pair per iteration.
+	datasets := []sort.Interface{
+		byLength{"banana", "fig", "kiwi", "apple"},
+		byValue{5, 3, 9, 1, 4},
+	}
+
+	for _, d := range datasets {
+		sort.Sort(d)
+		fmt.Println(d)
+	}
+}
@@ -0,0 +1,244 @@
+// Package trimmer removes WAT functions that are unreachable from a
+// module's retained entry points: its exports, its start function, and
+// anything a policy.Policy pins down explicitly.
+package trimmer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tamadalab/wasm-wat-trimming/policy"
+)
+
+// Trim removes unreachable functions from wat under the default
+// retention policy.
+func Trim(wat []byte) ([]byte, error) {
+	return TrimWithRetain(wat, policy.Default())
+}
+
+// TrimWithRetain removes every top-level (func ...) form from wat that
+// is not reachable from a root: a function pol.ShouldRetain approves
+// (which covers both pol's explicit lists and wat's own (export ...)
+// clauses, with no need to name an export in pol to keep it) or the
+// module's (start ...) function. A function referenced from an elem
+// segment is also retained whenever the module contains a
+// call_indirect, since such a function may be the target of an indirect
+// call that static call-graph analysis alone cannot see.
+func TrimWithRetain(wat []byte, pol policy.Policy) ([]byte, error) {
+	forms, err := topLevelForms(wat)
+	if err != nil {
+		return nil, fmt.Errorf("parse module: %w", err)
+	}
+
+	funcs := make(map[string]string, len(forms))
+	var order []string
+	for _, f := range forms {
+		if name, ok := funcNameOf(f); ok {
+			funcs[name] = f
+			order = append(order, name)
+		}
+	}
+
+	roots := map[string]bool{}
+	for _, name := range order {
+		// pol.ShouldRetain checks both the explicit policy lists and
+		// wat's own (export ...) clauses, so an export always survives
+		// even when a policy file doesn't name it.
+		if pol.ShouldRetain(strings.TrimPrefix(name, "$"), wat) {
+			roots[name] = true
+		}
+	}
+	for _, name := range startFuncs(wat) {
+		roots[name] = true
+	}
+	if hasCallIndirect(wat) {
+		for _, name := range elemFuncs(wat) {
+			roots[name] = true
+		}
+	}
+
+	reachable := map[string]bool{}
+	queue := make([]string, 0, len(roots))
+	for name := range roots {
+		queue = append(queue, name)
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if reachable[name] {
+			continue
+		}
+		reachable[name] = true
+
+		body, ok := funcs[name]
+		if !ok {
+			continue
+		}
+		for _, callee := range calledFuncs(body) {
+			if !reachable[callee] {
+				queue = append(queue, callee)
+			}
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString("(module\n")
+	for _, f := range forms {
+		if name, ok := funcNameOf(f); ok && !reachable[name] {
+			continue
+		}
+		out.WriteString("  ")
+		out.WriteString(f)
+		out.WriteString("\n")
+	}
+	out.WriteString(")\n")
+
+	return []byte(out.String()), nil
+}
+
+var (
+	funcNameRe = regexp.MustCompile(`^\(func\s+(\$[A-Za-z0-9_.\-]+)`)
+	// callRe matches a call target whether wasm2wat printed it folded,
+	// as "(call $foo)", or in its default unfolded instruction form,
+	// as a bare "call $foo" with no wrapping parens. Requiring
+	// whitespace after "call" keeps this from matching "call_indirect".
+	callRe        = regexp.MustCompile(`\bcall\s+(\$[A-Za-z0-9_.\-]+)`)
+	startRe       = regexp.MustCompile(`\(start\s+(\$[A-Za-z0-9_.\-]+)\)`)
+	elemFuncListR = regexp.MustCompile(`\$[A-Za-z0-9_.\-]+`)
+	elemBlockRe   = regexp.MustCompile(`\(elem\b[^)]*\)`)
+	callIndirectR = regexp.MustCompile(`call_indirect`)
+)
+
+func funcNameOf(form string) (string, bool) {
+	m := funcNameRe.FindStringSubmatch(form)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+func calledFuncs(body string) []string {
+	var names []string
+	for _, m := range callRe.FindAllStringSubmatch(body, -1) {
+		names = append(names, m[1])
+	}
+	return names
+}
+
+func startFuncs(wat []byte) []string {
+	var names []string
+	for _, m := range startRe.FindAllSubmatch(wat, -1) {
+		names = append(names, string(m[1]))
+	}
+	return names
+}
+
+func hasCallIndirect(wat []byte) bool {
+	return callIndirectR.Match(wat)
+}
+
+// elemFuncs returns every function name referenced anywhere inside an
+// (elem ...) segment, regardless of whether it's spelled as a bare
+// `$name` reference or wrapped in `(func $name)`.
+func elemFuncs(wat []byte) []string {
+	forms, err := topLevelForms(wat)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, form := range forms {
+		if !elemBlockRe.MatchString(form) {
+			continue
+		}
+		names = append(names, elemFuncListR.FindAllString(form, -1)...)
+	}
+	return names
+}
+
+// topLevelForms splits the contents of a (module ...) into its
+// top-level, paren-balanced forms (func, export, elem, start, ...).
+func topLevelForms(wat []byte) ([]string, error) {
+	text := string(wat)
+
+	start := strings.Index(text, "(module")
+	if start == -1 {
+		return nil, fmt.Errorf("no (module ...) form found")
+	}
+
+	depth := 0
+	bodyStart := -1
+	bodyEnd := -1
+	for i := start; i < len(text); i++ {
+		if text[i] == '"' {
+			i = skipString(text, i) - 1
+			continue
+		}
+		switch text[i] {
+		case '(':
+			depth++
+			if depth == 2 && bodyStart == -1 {
+				bodyStart = i
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				bodyEnd = i
+			}
+		}
+		if bodyEnd != -1 {
+			break
+		}
+	}
+	if bodyEnd == -1 {
+		return nil, fmt.Errorf("unbalanced parentheses in module")
+	}
+	if bodyStart == -1 {
+		return nil, nil
+	}
+
+	var forms []string
+	depth = 0
+	formStart := -1
+	for i := bodyStart; i < bodyEnd; i++ {
+		if text[i] == '"' {
+			i = skipString(text, i) - 1
+			continue
+		}
+		switch text[i] {
+		case '(':
+			if depth == 0 {
+				formStart = i
+			}
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				forms = append(forms, text[formStart:i+1])
+			}
+		}
+	}
+
+	return forms, nil
+}
+
+// skipString returns the index just past the closing quote of the WAT
+// string literal starting at i, which must point at the opening '"'.
+// Parens inside a string (e.g. a (data ...) segment's byte content) are
+// not structural and must not be counted as form boundaries; backslash
+// escapes the following byte so an escaped quote doesn't end the string
+// early.
+func skipString(text string, i int) int {
+	i++
+	for i < len(text) {
+		switch text[i] {
+		case '\\':
+			i += 2
+			continue
+		case '"':
+			return i + 1
+		}
+		i++
+	}
+	return i
+}
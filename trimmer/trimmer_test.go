@@ -0,0 +1,160 @@
+package trimmer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tamadalab/wasm-wat-trimming/policy"
+)
+
+const sampleWAT = `(module
+  (func $main
+    (call $used))
+  (func $used
+    nop)
+  (func $dead
+    nop)
+  (export "_start" (func $main)))
+`
+
+func TestTrimWithRetainDropsUnreachableFuncs(t *testing.T) {
+	out, err := TrimWithRetain([]byte(sampleWAT), policy.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	if !strings.Contains(text, "$main") {
+		t.Error("expected $main (exported) to survive trimming")
+	}
+	if !strings.Contains(text, "$used") {
+		t.Error("expected $used (reachable from $main) to survive trimming")
+	}
+	if strings.Contains(text, "$dead") {
+		t.Error("expected $dead (unreachable) to be trimmed")
+	}
+}
+
+const indirectWAT = `(module
+  (func $main
+    (call_indirect (i32.const 0)))
+  (func $indirectTarget
+    nop)
+  (elem (i32.const 0) $indirectTarget)
+  (func $dead
+    nop)
+  (export "_start" (func $main)))
+`
+
+func TestTrimWithRetainKeepsElemTargetsWhenCallIndirectPresent(t *testing.T) {
+	out, err := TrimWithRetain([]byte(indirectWAT), policy.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	if !strings.Contains(text, "$indirectTarget") {
+		t.Error("expected $indirectTarget to survive trimming because of call_indirect")
+	}
+	if strings.Contains(text, "$dead") {
+		t.Error("expected $dead (unreachable) to be trimmed")
+	}
+}
+
+const hostExportWAT = `(module
+  (func $main
+    nop)
+  (func $AddInts
+    nop)
+  (func $dead
+    nop)
+  (export "_start" (func $main))
+  (export "AddInts" (func $AddInts)))
+`
+
+// unfoldedWAT is shaped like wasm2wat's default (non-folded) instruction
+// stream: call targets appear as bare "call $foo" tokens on their own
+// line, never wrapped in parens the way hand-written folded WAT is.
+const unfoldedWAT = `(module
+  (func $main
+    i32.const 0
+    call $used
+    drop)
+  (func $used (result i32)
+    i32.const 1)
+  (func $dead (result i32)
+    i32.const 2)
+  (export "_start" (func $main)))
+`
+
+func TestTrimWithRetainFollowsUnfoldedCalls(t *testing.T) {
+	out, err := TrimWithRetain([]byte(unfoldedWAT), policy.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	if !strings.Contains(text, "(func $used") {
+		t.Error("expected $used (called via unfolded \"call $used\") to survive trimming")
+	}
+	if strings.Contains(text, "(func $dead") {
+		t.Error("expected $dead (unreachable) to be trimmed")
+	}
+}
+
+// dataParenWAT has a (data ...) segment whose string content contains a
+// literal ')', as real compiled string constants routinely do (panic
+// messages, struct tags, ...). A depth counter that doesn't track string
+// literals desyncs on it and corrupts the rest of the module.
+const dataParenWAT = `(module
+  (data (i32.const 0) "foo ) bar")
+  (func $main
+    (call $used))
+  (func $used
+    nop)
+  (func $dead
+    nop)
+  (export "_start" (func $main)))
+`
+
+func TestTrimWithRetainIgnoresParensInStringLiterals(t *testing.T) {
+	out, err := TrimWithRetain([]byte(dataParenWAT), policy.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	if !strings.Contains(text, `"foo ) bar"`) {
+		t.Error("expected the (data ...) segment to survive intact")
+	}
+	if !strings.Contains(text, "$main") || !strings.Contains(text, `(export "_start" (func $main))`) {
+		t.Error("expected $main and its export clause to survive")
+	}
+	if !strings.Contains(text, "$used") {
+		t.Error("expected $used (reachable from $main) to survive trimming")
+	}
+	if strings.Contains(text, "$dead") {
+		t.Error("expected $dead (unreachable) to be trimmed")
+	}
+}
+
+// TestTrimWithRetainKeepsUnlistedExports proves that a function exported
+// for the host to call survives trimming even when the policy passed to
+// TrimWithRetain never names it explicitly - wat's own (export ...)
+// clauses are enough.
+func TestTrimWithRetainKeepsUnlistedExports(t *testing.T) {
+	pol := policy.Default() // no Exports entry for "AddInts"
+
+	out, err := TrimWithRetain([]byte(hostExportWAT), pol)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	if !strings.Contains(text, "$AddInts") {
+		t.Error("expected $AddInts to survive trimming because it's exported, even though no policy lists it")
+	}
+	if strings.Contains(text, "$dead") {
+		t.Error("expected $dead (unreachable) to be trimmed")
+	}
+}
@@ -0,0 +1,49 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// WAT2WASMAssembler assembles WAT to WASM by shelling out to wabt's
+// wat2wasm binary.
+type WAT2WASMAssembler struct {
+	// Path is the wat2wasm binary to invoke. Defaults to "wat2wasm" on
+	// PATH when empty.
+	Path string
+}
+
+func (a WAT2WASMAssembler) Assemble(wat []byte) ([]byte, error) {
+	bin := a.Path
+	if bin == "" {
+		bin = "wat2wasm"
+	}
+
+	in, err := os.CreateTemp("", "wat-trim-verify-*.wat")
+	if err != nil {
+		return nil, fmt.Errorf("create temp wat file: %w", err)
+	}
+	defer os.Remove(in.Name())
+
+	if _, err := in.Write(wat); err != nil {
+		in.Close()
+		return nil, fmt.Errorf("write temp wat file: %w", err)
+	}
+	if err := in.Close(); err != nil {
+		return nil, fmt.Errorf("close temp wat file: %w", err)
+	}
+
+	out := in.Name() + ".wasm"
+	defer os.Remove(out)
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(bin, in.Name(), "-o", out)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", bin, err, stderr.String())
+	}
+
+	return os.ReadFile(out)
+}
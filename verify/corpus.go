@@ -0,0 +1,32 @@
+package verify
+
+// Corpus lists the samples with fully deterministic expected output, so a
+// PR that touches trimming rules can be checked against known-good
+// behavior rather than just before/after equality.
+//
+// WATPath is relative to the repo root, matching how the wat-trim CLI is
+// normally invoked; package tests that run with the package directory as
+// cwd (verify_test.go, bench_test.go) prepend "../" themselves.
+var Corpus = []Case{
+	{
+		Name:    "fizzbuzz",
+		WATPath: "trimming-middle/3000/data/fizzbuzz/fizzbuzz.wat",
+		WantStdout: "1\n2\nFizz\n4\nBuzz\nFizz\n7\n8\nFizz\nBuzz\n" +
+			"11\nFizz\n13\n14\nFizzBuzz\n16\n17\nFizz\n19\nBuzz\n" +
+			"Fizz\n22\n23\nFizz\nBuzz\n26\nFizz\n28\n29\nFizzBuzz\n",
+	},
+	{
+		Name:    "collatzSteps",
+		WATPath: "trimming-tail/5000/data/collatz/collatz.wat",
+		WantStdout: "Running Collatz Conjecture...\n" +
+			"Number: 27 -> Steps: 111\n" +
+			"Number: 871 -> Steps: 178\n" +
+			"Number: 6171 -> Steps: 261\n",
+	},
+	{
+		Name:    "bubbleSort",
+		WATPath: "trimming-tail/500/data/bubsort/bubsort.wat",
+		WantStdout: "Original: [64 34 25 12 22 11 90 88 15 76]\n" +
+			"Sorted: [11 12 15 22 25 34 64 76 88 90]\n",
+	},
+}
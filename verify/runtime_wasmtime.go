@@ -0,0 +1,77 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bytecodealliance/wasmtime-go/v25"
+)
+
+// WasmtimeRuntime runs a WASM module with wasmtime-go, capturing whatever
+// it writes to stdout via a temporary file backing the WASI pipe.
+type WasmtimeRuntime struct{}
+
+// NewWasmtimeRuntime returns a Runtime backed by wasmtime-go.
+func NewWasmtimeRuntime() *WasmtimeRuntime {
+	return &WasmtimeRuntime{}
+}
+
+func (r *WasmtimeRuntime) Name() string { return "wasmtime" }
+
+func (r *WasmtimeRuntime) Run(_ context.Context, wasm []byte, args []string) (string, int, error) {
+	stdoutFile, err := os.CreateTemp("", "wat-trim-verify-stdout-*")
+	if err != nil {
+		return "", 0, fmt.Errorf("create stdout capture file: %w", err)
+	}
+	defer os.Remove(stdoutFile.Name())
+	defer stdoutFile.Close()
+
+	engine := wasmtime.NewEngine()
+	store := wasmtime.NewStore(engine)
+
+	wasiConfig := wasmtime.NewWasiConfig()
+	wasiConfig.SetArgv(append([]string{"main"}, args...))
+	wasiConfig.SetStdoutFile(stdoutFile.Name())
+	store.SetWasi(wasiConfig)
+
+	linker := wasmtime.NewLinker(engine)
+	if err := linker.DefineWasi(); err != nil {
+		return "", 0, fmt.Errorf("define wasi: %w", err)
+	}
+
+	module, err := wasmtime.NewModule(engine, wasm)
+	if err != nil {
+		return "", 0, fmt.Errorf("compile module: %w", err)
+	}
+
+	instance, err := linker.Instantiate(store, module)
+	if err != nil {
+		return "", 0, fmt.Errorf("instantiate module: %w", err)
+	}
+
+	exitCode := 0
+	start := instance.GetFunc(store, "_start")
+	if start == nil {
+		return "", 0, fmt.Errorf("module has no _start export")
+	}
+	if _, err := start.Call(store); err != nil {
+		wasmErr, ok := err.(*wasmtime.Error)
+		if !ok {
+			return "", 0, err
+		}
+		code, isExit := wasmErr.ExitStatus()
+		if !isExit {
+			return "", 0, wasmErr
+		}
+		exitCode = int(code)
+	}
+
+	stdout, err := os.ReadFile(stdoutFile.Name())
+	if err != nil {
+		return "", 0, fmt.Errorf("read captured stdout: %w", err)
+	}
+
+	return string(bytes.TrimRight(stdout, "")), exitCode, nil
+}
@@ -0,0 +1,51 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// WazeroRuntime runs a WASM module with the wazero runtime, capturing
+// whatever it writes to stdout via WASI.
+type WazeroRuntime struct{}
+
+// NewWazeroRuntime returns a Runtime backed by wazero.
+func NewWazeroRuntime() *WazeroRuntime {
+	return &WazeroRuntime{}
+}
+
+func (r *WazeroRuntime) Name() string { return "wazero" }
+
+func (r *WazeroRuntime) Run(ctx context.Context, wasm []byte, args []string) (string, int, error) {
+	runtime := wazero.NewRuntime(ctx)
+	defer runtime.Close(ctx)
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return "", 0, fmt.Errorf("instantiate wasi: %w", err)
+	}
+
+	var stdout bytes.Buffer
+	config := wazero.NewModuleConfig().
+		WithStdout(&stdout).
+		WithArgs(append([]string{"main"}, args...)...)
+
+	mod, err := runtime.InstantiateWithConfig(ctx, wasm, config)
+	if mod != nil {
+		defer mod.Close(ctx)
+	}
+
+	exitCode := 0
+	if exitErr, ok := err.(interface{ ExitCode() uint32 }); ok {
+		exitCode = int(exitErr.ExitCode())
+		err = nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	return stdout.String(), exitCode, nil
+}
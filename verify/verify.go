@@ -0,0 +1,98 @@
+// Package verify checks that trimming a WAT module does not change the
+// observable behavior of the program it came from. It assembles a WAT
+// module to WASM, runs it under a pluggable Runtime, trims it, reassembles
+// the result, runs it again, and compares stdout and exit code.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// Assembler turns WAT text into a WASM binary, e.g. via wabt's wat2wasm.
+type Assembler interface {
+	Assemble(wat []byte) ([]byte, error)
+}
+
+// Trimmer removes dead code from a WAT module.
+type Trimmer interface {
+	Trim(wat []byte) ([]byte, error)
+}
+
+// Runtime executes a WASM module and captures what the program printed.
+type Runtime interface {
+	Name() string
+	Run(ctx context.Context, wasm []byte, args []string) (stdout string, exitCode int, err error)
+}
+
+// Case is one entry in the regression corpus: a WAT source and the
+// behavior it must preserve after trimming.
+type Case struct {
+	Name       string
+	WATPath    string
+	Args       []string
+	WantStdout string
+	WantExit   int
+}
+
+// Result is the outcome of verifying a single Case.
+type Result struct {
+	Case           Case
+	BeforeStdout   string
+	AfterStdout    string
+	BeforeExitCode int
+	AfterExitCode  int
+}
+
+// Run assembles wat, executes it before and after trimming, and returns an
+// error if the trimmed module's behavior diverges from either the
+// original run or the Case's expected output.
+func Run(ctx context.Context, rt Runtime, asm Assembler, tr Trimmer, c Case, wat []byte) (Result, error) {
+	beforeWasm, err := asm.Assemble(wat)
+	if err != nil {
+		return Result{}, fmt.Errorf("assemble original %s: %w", c.Name, err)
+	}
+
+	beforeOut, beforeExit, err := rt.Run(ctx, beforeWasm, c.Args)
+	if err != nil {
+		return Result{}, fmt.Errorf("run original %s under %s: %w", c.Name, rt.Name(), err)
+	}
+
+	trimmed, err := tr.Trim(wat)
+	if err != nil {
+		return Result{}, fmt.Errorf("trim %s: %w", c.Name, err)
+	}
+
+	afterWasm, err := asm.Assemble(trimmed)
+	if err != nil {
+		return Result{}, fmt.Errorf("assemble trimmed %s: %w", c.Name, err)
+	}
+
+	afterOut, afterExit, err := rt.Run(ctx, afterWasm, c.Args)
+	if err != nil {
+		return Result{}, fmt.Errorf("run trimmed %s under %s: %w", c.Name, rt.Name(), err)
+	}
+
+	result := Result{
+		Case:           c,
+		BeforeStdout:   beforeOut,
+		AfterStdout:    afterOut,
+		BeforeExitCode: beforeExit,
+		AfterExitCode:  afterExit,
+	}
+
+	if beforeOut != afterOut || beforeExit != afterExit {
+		return result, fmt.Errorf("%s: trimming changed behavior under %s (exit %d vs %d)", c.Name, rt.Name(), beforeExit, afterExit)
+	}
+
+	if c.WantStdout != "" && !bytes.Equal([]byte(afterOut), []byte(c.WantStdout)) {
+		return result, fmt.Errorf("%s: trimmed output does not match expected output", c.Name)
+	}
+
+	if afterExit != c.WantExit {
+		return result, fmt.Errorf("%s: trimmed exit code %d, want %d", c.Name, afterExit, c.WantExit)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package verify
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tamadalab/wasm-wat-trimming/trimmer"
+)
+
+// trimmerFunc adapts trimmer.Trim to the Trimmer interface.
+type trimmerFunc func([]byte) ([]byte, error)
+
+func (f trimmerFunc) Trim(wat []byte) ([]byte, error) { return f(wat) }
+
+// TestCorpus runs every entry in Corpus through Run using the wazero
+// runtime, so any PR that touches trimming rules is checked against the
+// whole sample corpus. A missing .wat fixture fails the test rather than
+// skipping it - a skip here would let CI go green without ever running
+// the corpus it's meant to guard. Run `make fixtures` (or
+// scripts/gen-fixtures.sh) to build them.
+func TestCorpus(t *testing.T) {
+	rt := NewWazeroRuntime()
+	tr := trimmerFunc(trimmer.Trim)
+
+	for _, c := range Corpus {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			// Corpus's WATPath is relative to the repo root (how the CLI
+			// uses it); go test runs with this package's directory as
+			// cwd, one level down.
+			watPath := filepath.Join("..", c.WATPath)
+			wat, err := os.ReadFile(watPath)
+			if os.IsNotExist(err) {
+				t.Fatalf("%s not built yet, run `make fixtures` to generate it", watPath)
+			}
+			if err != nil {
+				t.Fatalf("read %s: %v", watPath, err)
+			}
+
+			if _, err := Run(context.Background(), rt, WAT2WASMAssembler{}, tr, c, wat); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}